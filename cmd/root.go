@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ebay/sbom-scorecard/pkg/cyclonedx"
+	"github.com/ebay/sbom-scorecard/pkg/scorecard"
+	"github.com/ebay/sbom-scorecard/pkg/spdx"
+
+	"github.com/alexeyco/simpletable"
+	"github.com/spf13/cobra"
+)
+
+var (
+	file          string
+	licenseReport bool
+	creatorPolicy string
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "sbom-scorecard",
+	Short: "Scores an SBOM on how complete and useful it is",
+	RunE:  run,
+}
+
+func init() {
+	rootCmd.Flags().StringVar(&file, "file", "", "path to the SBOM to score")
+	rootCmd.Flags().BoolVar(&licenseReport, "license-report", false, "print a license distribution table alongside the score")
+	rootCmd.Flags().StringVar(&creatorPolicy, "creator-policy", "", "path to a YAML policy file of known-good creator tools and minimum versions")
+	rootCmd.MarkFlagRequired("file")
+}
+
+func Execute() error {
+	return rootCmd.Execute()
+}
+
+// licenseDistributor is implemented by report types that track license
+// counts; not every SbomReport is guaranteed to support it.
+type licenseDistributor interface {
+	LicenseDistribution() map[string]int
+	LicenseUnknownCount() int
+}
+
+// creatorPolicySetter is implemented by report types whose CreationInfo
+// check can be driven by a CreatorPolicy instead of the default heuristic.
+type creatorPolicySetter interface {
+	SetCreatorPolicy(*scorecard.CreatorPolicy)
+}
+
+func loadReport(path string) (scorecard.SbomReport, error) {
+	if _, err := spdx.LoadDocument(path); err == nil {
+		return spdx.GetSpdxReport(path), nil
+	}
+	if _, err := cyclonedx.LoadDocument(path); err == nil {
+		return cyclonedx.GetCycloneDXReport(path), nil
+	}
+	return nil, fmt.Errorf("%s does not appear to be a valid SPDX or CycloneDX document", path)
+}
+
+func run(cmd *cobra.Command, args []string) error {
+	report, err := loadReport(file)
+	if err != nil {
+		return err
+	}
+
+	if creatorPolicy != "" {
+		policy, err := scorecard.LoadCreatorPolicy(creatorPolicy)
+		if err != nil {
+			return err
+		}
+		if setter, ok := report.(creatorPolicySetter); ok {
+			setter.SetCreatorPolicy(policy)
+		}
+	}
+
+	fmt.Println(report.Report())
+
+	grade := scorecard.GetGrade(report)
+	fmt.Printf("Overall grade: %s\n", grade)
+
+	if licenseReport {
+		distributor, ok := report.(licenseDistributor)
+		if !ok {
+			return fmt.Errorf("license reporting is not supported for this SBOM format")
+		}
+		printLicenseDistribution(distributor)
+	}
+
+	return nil
+}
+
+func printLicenseDistribution(d licenseDistributor) {
+	licenses := d.LicenseDistribution()
+
+	keys := make([]string, 0, len(licenses))
+	for license := range licenses {
+		keys = append(keys, license)
+	}
+	sort.Strings(keys)
+
+	table := simpletable.New()
+	table.Header = &simpletable.Header{
+		Cells: []*simpletable.Cell{
+			{Align: simpletable.AlignLeft, Text: "License"},
+			{Align: simpletable.AlignRight, Text: "Count"},
+		},
+	}
+
+	for _, license := range keys {
+		table.Body.Cells = append(table.Body.Cells, []*simpletable.Cell{
+			{Align: simpletable.AlignLeft, Text: license},
+			{Align: simpletable.AlignRight, Text: fmt.Sprintf("%d", licenses[license])},
+		})
+	}
+	table.Body.Cells = append(table.Body.Cells, []*simpletable.Cell{
+		{Align: simpletable.AlignLeft, Text: "NOASSERTION/NONE"},
+		{Align: simpletable.AlignRight, Text: fmt.Sprintf("%d", d.LicenseUnknownCount())},
+	})
+
+	table.SetStyle(simpletable.StyleDefault)
+	fmt.Println(table.String())
+}
@@ -0,0 +1,298 @@
+package cyclonedx
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ebay/sbom-scorecard/pkg/scorecard"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+)
+
+var isNumeric = func(s string) bool {
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			return true
+		}
+	}
+	return false
+}
+
+type CycloneDXReport struct {
+	bom      cdx.BOM
+	bomError error
+
+	totalComponents int
+	hasLicense      int
+	hasPurl         int
+	hasCPE          int
+	hasPurlOrCPE    int
+	hasVersion      int
+
+	licenseCounts  map[string]int
+	licenseUnknown int
+
+	connectedComponents int
+
+	creatorPolicy *scorecard.CreatorPolicy
+}
+
+// SetCreatorPolicy configures CreationInfo to check the document's creator
+// tools against an allow/deny list instead of only checking that a tool
+// with some version string was used.
+func (r *CycloneDXReport) SetCreatorPolicy(p *scorecard.CreatorPolicy) {
+	r.creatorPolicy = p
+}
+
+func recordLicense(counts map[string]int, unknown *int, license string) {
+	switch license {
+	case "", "NONE", "NOASSERTION":
+		*unknown += 1
+	default:
+		counts[license] += 1
+	}
+}
+
+func (r *CycloneDXReport) Metadata() scorecard.ReportMetadata {
+	return scorecard.ReportMetadata{
+		TotalPackages: r.totalComponents,
+	}
+}
+
+func (r *CycloneDXReport) Report() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%d total components\n", r.totalComponents))
+	sb.WriteString(fmt.Sprintf("%d%% have licenses.\n", scorecard.PrettyPercent(r.hasLicense, r.totalComponents)))
+	sb.WriteString(fmt.Sprintf("%d%% have component versions.\n", scorecard.PrettyPercent(r.hasVersion, r.totalComponents)))
+	sb.WriteString(fmt.Sprintf("%d%% have purls.\n", scorecard.PrettyPercent(r.hasPurl, r.totalComponents)))
+	sb.WriteString(fmt.Sprintf("%d%% have CPEs.\n", scorecard.PrettyPercent(r.hasCPE, r.totalComponents)))
+	sb.WriteString(fmt.Sprintf("%d%% of components appear in the dependency graph.\n", scorecard.PrettyPercent(r.connectedComponents, r.totalComponents)))
+	sb.WriteString(fmt.Sprintf("Spec valid? %v\n", r.bomError == nil))
+	sb.WriteString(fmt.Sprintf("Has creation info? %v\n", r.CreationInfo().Ratio == 1))
+
+	return sb.String()
+}
+
+func (r *CycloneDXReport) IsSpecCompliant() scorecard.ReportValue {
+	if r.bomError != nil {
+		return scorecard.ReportValue{
+			Ratio:     0,
+			Reasoning: r.bomError.Error(),
+		}
+	}
+	return scorecard.ReportValue{Ratio: 1}
+}
+
+func (r *CycloneDXReport) PackageIdentification() scorecard.ReportValue {
+	if r.totalComponents == 0 {
+		return scorecard.ReportValue{Ratio: 0, Reasoning: "No components"}
+	}
+	purlPercent := scorecard.PrettyPercent(r.hasPurl, r.totalComponents)
+	cpePercent := scorecard.PrettyPercent(r.hasCPE, r.totalComponents)
+	either := scorecard.PrettyPercent(r.hasPurlOrCPE, r.totalComponents)
+	return scorecard.ReportValue{
+		Ratio:     float32(r.hasPurlOrCPE) / float32(r.totalComponents),
+		Reasoning: fmt.Sprintf("%d%% have either purls (%d%%) or CPEs (%d%%)", either, purlPercent, cpePercent),
+	}
+}
+
+func (r *CycloneDXReport) PackageVersions() scorecard.ReportValue {
+	if r.totalComponents == 0 {
+		return scorecard.ReportValue{Ratio: 0, Reasoning: "No components"}
+	}
+	return scorecard.ReportValue{
+		Ratio: float32(r.hasVersion) / float32(r.totalComponents),
+	}
+}
+
+func (r *CycloneDXReport) PackageLicenses() scorecard.ReportValue {
+	if r.totalComponents == 0 {
+		return scorecard.ReportValue{Ratio: 0, Reasoning: "No components"}
+	}
+	return scorecard.ReportValue{
+		Ratio: float32(r.hasLicense) / float32(r.totalComponents),
+	}
+}
+
+func (r *CycloneDXReport) CreationInfo() scorecard.ReportValue {
+	if r.bom.Metadata == nil || r.bom.Metadata.Tools == nil || len(*r.bom.Metadata.Tools) == 0 {
+		return scorecard.ReportValue{
+			Ratio:     0,
+			Reasoning: "No tool was used to create the sbom",
+		}
+	}
+
+	if r.creatorPolicy != nil {
+		for _, tool := range *r.bom.Metadata.Tools {
+			result := r.creatorPolicy.Evaluate(tool.Name, tool.Version)
+			if result.Ratio == 1 {
+				return result
+			}
+		}
+		return scorecard.ReportValue{
+			Ratio:     0,
+			Reasoning: "No creator tool on the document satisfies policy",
+		}
+	}
+
+	hasVersion := false
+	for _, tool := range *r.bom.Metadata.Tools {
+		if isNumeric(tool.Version) {
+			hasVersion = true
+		}
+	}
+
+	var score float32 = 1.0
+	reasons := []string{}
+
+	if !hasVersion {
+		score -= .2
+		reasons = append(reasons, "The tool used to create the sbom does not have a version")
+	}
+
+	if r.bom.Metadata.Timestamp == "" {
+		score -= .2
+		reasons = append(reasons, "There is no timestamp for when the sbom was created")
+	}
+
+	return scorecard.ReportValue{
+		Ratio:     score,
+		Reasoning: strings.Join(reasons, ", "),
+	}
+}
+
+// LicenseDistribution returns a histogram of component licenses, keyed by
+// the first license ID, name or expression listed on each component; any
+// further licenses on a component are not counted. Components with no
+// usable license information are not included here; see LicenseUnknownCount.
+func (r *CycloneDXReport) LicenseDistribution() map[string]int {
+	return r.licenseCounts
+}
+
+// LicenseUnknownCount returns how many components had no license ID, name
+// or expression set, or whose license was NONE or NOASSERTION.
+func (r *CycloneDXReport) LicenseUnknownCount() int {
+	return r.licenseUnknown
+}
+
+// DependencyCompleteness scores how much of the CycloneDX "dependencies"
+// graph is populated, rather than the BOM being a flat list of components.
+// It's the CycloneDX analogue of the SPDX relationship completeness check.
+func (r *CycloneDXReport) DependencyCompleteness() scorecard.ReportValue {
+	if r.totalComponents == 0 {
+		return scorecard.ReportValue{Ratio: 0, Reasoning: "No components"}
+	}
+	percent := scorecard.PrettyPercent(r.connectedComponents, r.totalComponents)
+	orphans := r.totalComponents - r.connectedComponents
+	return scorecard.ReportValue{
+		Ratio:     float32(r.connectedComponents) / float32(r.totalComponents),
+		Reasoning: fmt.Sprintf("%d%% of components appear in the dependency graph (%d orphaned)", percent, orphans),
+	}
+}
+
+func componentLicense(c cdx.Component) string {
+	if c.Licenses == nil {
+		return ""
+	}
+	for _, choice := range *c.Licenses {
+		if choice.License != nil {
+			if choice.License.ID != "" {
+				return choice.License.ID
+			}
+			if choice.License.Name != "" {
+				return choice.License.Name
+			}
+		}
+		if choice.Expression != "" {
+			return choice.Expression
+		}
+	}
+	return ""
+}
+
+func LoadDocument(path string) (*cdx.BOM, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening CycloneDX document: %w", err)
+	}
+	defer f.Close()
+
+	bom := cdx.NewBOM()
+	decoder := cdx.NewBOMDecoder(f, cdx.BOMFileFormatJSON)
+	if err := decoder.Decode(bom); err != nil {
+		return nil, fmt.Errorf("decoding CycloneDX document: %w", err)
+	}
+	return bom, nil
+}
+
+func GetCycloneDXReport(filename string) scorecard.SbomReport {
+	cr := CycloneDXReport{}
+	cr.licenseCounts = map[string]int{}
+
+	bom, err := LoadDocument(filename)
+	if err != nil {
+		fmt.Printf("loading document: %v\n", err)
+		cr.bomError = err
+		return &cr
+	}
+	cr.bom = *bom
+
+	if bom.Components == nil {
+		return &cr
+	}
+
+	for _, c := range *bom.Components {
+		cr.totalComponents += 1
+
+		license := componentLicense(c)
+		recordLicense(cr.licenseCounts, &cr.licenseUnknown, license)
+		if license != "" {
+			cr.hasLicense += 1
+		}
+
+		if c.Version != "" {
+			cr.hasVersion += 1
+		}
+
+		var foundPURL, foundCPE bool
+		if c.PackageURL != "" {
+			cr.hasPurl += 1
+			foundPURL = true
+		}
+		if c.CPE != "" {
+			cr.hasCPE += 1
+			foundCPE = true
+		}
+		if foundPURL || foundCPE {
+			cr.hasPurlOrCPE += 1
+		}
+	}
+
+	connected := map[string]bool{}
+	for _, c := range *bom.Components {
+		connected[c.BOMRef] = false
+	}
+	if bom.Dependencies != nil {
+		for _, dep := range *bom.Dependencies {
+			if _, ok := connected[dep.Ref]; ok {
+				connected[dep.Ref] = true
+			}
+			if dep.Dependencies == nil {
+				continue
+			}
+			for _, child := range *dep.Dependencies {
+				if _, ok := connected[child]; ok {
+					connected[child] = true
+				}
+			}
+		}
+	}
+	for _, isConnected := range connected {
+		if isConnected {
+			cr.connectedComponents += 1
+		}
+	}
+
+	return &cr
+}
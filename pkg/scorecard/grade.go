@@ -0,0 +1,104 @@
+package scorecard
+
+import "fmt"
+
+// Grade is the overall weighted score for an SBOM, along with the
+// per-category breakdown that produced it.
+type Grade struct {
+	Score      float32
+	Categories map[string]ReportValue
+}
+
+// weightedCheck ties a named scorecard category to the weight it
+// contributes to the overall grade. value returns false when the report
+// doesn't support the check, so the category is skipped and its weight
+// redistributed rather than counted as a zero.
+type weightedCheck struct {
+	name   string
+	weight float32
+	value  func(SbomReport) (ReportValue, bool)
+}
+
+var checks = []weightedCheck{
+	{"Spec Compliance", 0.15, func(r SbomReport) (ReportValue, bool) {
+		v, ok := r.(interface{ IsSpecCompliant() ReportValue })
+		if !ok {
+			return ReportValue{}, false
+		}
+		return v.IsSpecCompliant(), true
+	}},
+	{"Package Identification", 0.15, func(r SbomReport) (ReportValue, bool) {
+		v, ok := r.(interface{ PackageIdentification() ReportValue })
+		if !ok {
+			return ReportValue{}, false
+		}
+		return v.PackageIdentification(), true
+	}},
+	{"Package Versions", 0.15, func(r SbomReport) (ReportValue, bool) {
+		v, ok := r.(interface{ PackageVersions() ReportValue })
+		if !ok {
+			return ReportValue{}, false
+		}
+		return v.PackageVersions(), true
+	}},
+	{"Package Licenses", 0.15, func(r SbomReport) (ReportValue, bool) {
+		v, ok := r.(interface{ PackageLicenses() ReportValue })
+		if !ok {
+			return ReportValue{}, false
+		}
+		return v.PackageLicenses(), true
+	}},
+	{"Creation Info", 0.15, func(r SbomReport) (ReportValue, bool) {
+		v, ok := r.(interface{ CreationInfo() ReportValue })
+		if !ok {
+			return ReportValue{}, false
+		}
+		return v.CreationInfo(), true
+	}},
+	{"Relationship Completeness", 0.25, func(r SbomReport) (ReportValue, bool) {
+		if v, ok := r.(interface {
+			RelationshipCompleteness() ReportValue
+		}); ok {
+			return v.RelationshipCompleteness(), true
+		}
+		if v, ok := r.(interface {
+			DependencyCompleteness() ReportValue
+		}); ok {
+			return v.DependencyCompleteness(), true
+		}
+		return ReportValue{}, false
+	}},
+}
+
+// GetGrade runs every weighted category check the report supports and
+// combines them into an overall score. Checks a report doesn't implement
+// (e.g. a relationship graph check on a format that lacks one) are
+// skipped and their weight is redistributed across the remaining checks,
+// so the absence of a check doesn't unfairly tank the score.
+func GetGrade(r SbomReport) Grade {
+	g := Grade{Categories: map[string]ReportValue{}}
+
+	var totalWeight float32
+	applicable := []weightedCheck{}
+	for _, c := range checks {
+		if v, ok := c.value(r); ok {
+			g.Categories[c.name] = v
+			applicable = append(applicable, c)
+			totalWeight += c.weight
+		}
+	}
+
+	if totalWeight == 0 {
+		return g
+	}
+
+	for _, c := range applicable {
+		g.Score += g.Categories[c.name].Ratio * (c.weight / totalWeight)
+	}
+
+	return g
+}
+
+func (g Grade) String() string {
+	return fmt.Sprintf("%.0f%%", g.Score*100)
+}
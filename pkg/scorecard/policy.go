@@ -0,0 +1,92 @@
+package scorecard
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+)
+
+// ToolPolicy describes the acceptable version range for a single creator
+// tool: a version below MinimumVersion, or matching one of DeniedVersions,
+// fails policy.
+type ToolPolicy struct {
+	Name           string   `yaml:"name"`
+	MinimumVersion string   `yaml:"minimumVersion"`
+	DeniedVersions []string `yaml:"deniedVersions"`
+}
+
+// CreatorPolicy is a user-supplied allow/deny list of creator tools and
+// versions, loaded from YAML via LoadCreatorPolicy.
+type CreatorPolicy struct {
+	Tools []ToolPolicy `yaml:"tools"`
+}
+
+func LoadCreatorPolicy(path string) (*CreatorPolicy, error) {
+	f, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening creator policy: %w", err)
+	}
+
+	var policy CreatorPolicy
+	if err := yaml.Unmarshal(f, &policy); err != nil {
+		return nil, fmt.Errorf("parsing creator policy: %w", err)
+	}
+	return &policy, nil
+}
+
+func (p *CreatorPolicy) find(tool string) (ToolPolicy, bool) {
+	for _, t := range p.Tools {
+		if strings.EqualFold(t.Name, tool) {
+			return t, true
+		}
+	}
+	return ToolPolicy{}, false
+}
+
+// canonicalSemver prefixes a bare version like "0.80.1" with "v", since
+// golang.org/x/mod/semver requires the leading "v".
+func canonicalSemver(v string) string {
+	if v == "" || strings.HasPrefix(v, "v") {
+		return v
+	}
+	return "v" + v
+}
+
+// Evaluate checks a discovered creator tool/version pair against the
+// policy, returning a ReportValue whose reasoning explains exactly which
+// tool/version was found and whether it satisfies policy.
+func (p *CreatorPolicy) Evaluate(tool, version string) ReportValue {
+	policy, ok := p.find(tool)
+	if !ok {
+		return ReportValue{
+			Ratio:     0,
+			Reasoning: fmt.Sprintf("%s is not on the list of known-good creator tools", tool),
+		}
+	}
+
+	canonical := canonicalSemver(version)
+
+	for _, denied := range policy.DeniedVersions {
+		if canonicalSemver(denied) == canonical {
+			return ReportValue{
+				Ratio:     0,
+				Reasoning: fmt.Sprintf("%s %s is denylisted by policy", tool, version),
+			}
+		}
+	}
+
+	if policy.MinimumVersion != "" && semver.Compare(canonical, canonicalSemver(policy.MinimumVersion)) < 0 {
+		return ReportValue{
+			Ratio:     0,
+			Reasoning: fmt.Sprintf("%s %s is below the minimum policy version %s", tool, version, policy.MinimumVersion),
+		}
+	}
+
+	return ReportValue{
+		Ratio:     1,
+		Reasoning: fmt.Sprintf("%s %s satisfies policy", tool, version),
+	}
+}
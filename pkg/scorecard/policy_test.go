@@ -0,0 +1,44 @@
+package scorecard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testPolicy() *CreatorPolicy {
+	return &CreatorPolicy{
+		Tools: []ToolPolicy{
+			{Name: "syft", MinimumVersion: "0.80.0", DeniedVersions: []string{"0.90.0"}},
+			{Name: "trivy", MinimumVersion: "v0.40.0"},
+		},
+	}
+}
+
+func TestCreatorPolicyEvaluate(t *testing.T) {
+	p := testPolicy()
+
+	tests := []struct {
+		name      string
+		tool      string
+		version   string
+		wantRatio float32
+	}{
+		{"unknown tool", "cyclonedx-cli", "1.0.0", 0},
+		{"below minimum", "syft", "0.79.9", 0},
+		{"meets minimum", "syft", "0.80.0", 1},
+		{"above minimum, no v-prefix", "syft", "1.0.0", 1},
+		{"denylisted version", "syft", "0.90.0", 0},
+		{"minimum already v-prefixed", "trivy", "0.39.9", 0},
+		{"minimum already v-prefixed, satisfied", "trivy", "0.40.0", 1},
+		{"tool name is case-insensitive", "Syft", "0.80.0", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := p.Evaluate(tt.tool, tt.version)
+			assert.Equal(t, tt.wantRatio, result.Ratio)
+			assert.NotEmpty(t, result.Reasoning)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package scorecard
+
+// ReportValue is the result of a single scorecard check: a ratio between
+// 0 and 1 along with a human readable explanation of how it was derived.
+type ReportValue struct {
+	Ratio     float32
+	Reasoning string
+}
+
+// ReportMetadata carries summary information about the SBOM that is common
+// across formats, independent of any particular check.
+type ReportMetadata struct {
+	TotalPackages int
+}
+
+// SbomReport is implemented by each format-specific report (SPDX,
+// CycloneDX, ...). Individual checks beyond Metadata/Report are exposed as
+// additional methods on the concrete type and are picked up by GetGrade via
+// type assertion, since not every format supports every check.
+type SbomReport interface {
+	Metadata() ReportMetadata
+	Report() string
+}
+
+// PrettyPercent renders have/total as a whole-number percentage, treating
+// a zero total as 0% rather than dividing by zero.
+func PrettyPercent(have, total int) int {
+	if total == 0 {
+		return 0
+	}
+	return int(float32(have) / float32(total) * 100)
+}
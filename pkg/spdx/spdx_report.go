@@ -30,15 +30,105 @@ type SpdxReport struct {
 	docError error
 	valid    bool
 
-	totalPackages int
-	totalFiles    int
-	hasLicense    int
-	hasPackDigest int
-	hasPurl       int
-	hasCPE        int
-	hasPurlOrCPE  int
-	hasFileDigest int
-	hasPackVer    int
+	totalPackages    int
+	totalFiles       int
+	hasLicense       int
+	hasPackDigest    int
+	hasPurl          int
+	hasCPE           int
+	hasPurlOrCPE     int
+	hasFileDigest    int
+	hasPackVer       int
+	hasPackIntegrity int
+
+	licenseCounts  map[string]int
+	licenseUnknown int
+
+	hasDescribesRoot  bool
+	connectedPackages int
+
+	creatorPolicy *scorecard.CreatorPolicy
+}
+
+// SetCreatorPolicy configures CreationInfo to check the document's creator
+// tool against an allow/deny list instead of only checking that a tool
+// with some version string was used.
+func (r *SpdxReport) SetCreatorPolicy(p *scorecard.CreatorPolicy) {
+	r.creatorPolicy = p
+}
+
+// parseCreatorTool splits an SPDX "Tool" creator string of the form
+// "name-version" (e.g. "syft-0.98.0") into its name and version.
+func parseCreatorTool(creator string) (tool, version string, ok bool) {
+	idx := strings.LastIndex(creator, "-")
+	if idx == -1 {
+		return "", "", false
+	}
+	return creator[:idx], creator[idx+1:], true
+}
+
+// filesAnalyzed reports whether a package's file-level data should be
+// considered present. Per the SPDX spec, FilesAnalyzed defaults to true
+// when the tag is absent from the document.
+func filesAnalyzed(p *spdx.Package) bool {
+	if !p.IsFilesAnalyzedTagPresent {
+		return true
+	}
+	return p.FilesAnalyzed
+}
+
+func hasVerificationCode(p *spdx.Package) bool {
+	return p.PackageVerificationCode != nil && p.PackageVerificationCode.Value != ""
+}
+
+// packageFilesByID resolves which files belong to which package via
+// CONTAINS relationships. tools-golang converts a package's "hasFiles"
+// into document-level CONTAINS relationships rather than populating
+// Package.Files, so that's the only place this membership is recoverable.
+func packageFilesByID(doc spdx.Document) map[string][]*spdx.File {
+	fileByID := map[string]*spdx.File{}
+	for _, f := range doc.Files {
+		fileByID[fmt.Sprintf("%v", f.FileSPDXIdentifier)] = f
+	}
+
+	packageFiles := map[string][]*spdx.File{}
+	for _, rel := range doc.Relationships {
+		if rel.Relationship != "CONTAINS" || rel.RefA.DocumentRefID != "" || rel.RefA.SpecialID != "" {
+			continue
+		}
+		if rel.RefB.DocumentRefID != "" || rel.RefB.SpecialID != "" {
+			continue
+		}
+		file, ok := fileByID[fmt.Sprintf("%v", rel.RefB.ElementRefID)]
+		if !ok {
+			continue
+		}
+		packageID := fmt.Sprintf("%v", rel.RefA.ElementRefID)
+		packageFiles[packageID] = append(packageFiles[packageID], file)
+	}
+	return packageFiles
+}
+
+func isDocumentElement(ref spdx.DocElementID) bool {
+	return ref.DocumentRefID == "" && string(ref.ElementRefID) == "DOCUMENT"
+}
+
+func markConnected(ids map[string]bool, ref spdx.DocElementID) {
+	if ref.SpecialID != "" || ref.DocumentRefID != "" {
+		return
+	}
+	if _, ok := ids[string(ref.ElementRefID)]; ok {
+		ids[string(ref.ElementRefID)] = true
+	}
+}
+
+func recordLicense(counts map[string]int, unknown *int, license string) {
+	switch license {
+	case "", "NONE", "NOASSERTION":
+		*unknown += 1
+	default:
+		counts[license] += 1
+	}
 }
 
 func (r *SpdxReport) Metadata() scorecard.ReportMetadata {
@@ -57,6 +147,8 @@ func (r *SpdxReport) Report() string {
 	sb.WriteString(fmt.Sprintf("%d%% have purls.\n", scorecard.PrettyPercent(r.hasPurl, r.totalPackages)))
 	sb.WriteString(fmt.Sprintf("%d%% have CPEs.\n", scorecard.PrettyPercent(r.hasCPE, r.totalPackages)))
 	sb.WriteString(fmt.Sprintf("%d%% have file digest.\n", scorecard.PrettyPercent(r.hasFileDigest, r.totalFiles)))
+	sb.WriteString(fmt.Sprintf("%d%% have package integrity (file digests or verification code).\n", scorecard.PrettyPercent(r.hasPackIntegrity, r.totalPackages)))
+	sb.WriteString(fmt.Sprintf("%d%% of packages participate in a relationship.\n", scorecard.PrettyPercent(r.connectedPackages, r.totalPackages)))
 	sb.WriteString(fmt.Sprintf("Spec valid? %v\n", r.valid))
 	sb.WriteString(fmt.Sprintf("Has creation info? %v\n", r.CreationInfo().Ratio == 1))
 
@@ -111,9 +203,71 @@ func (r *SpdxReport) PackageLicenses() scorecard.ReportValue {
 	}
 }
 
+// LicenseDistribution returns a histogram of package and file licenses,
+// keyed by SPDX license expression. Each package counts once, under its
+// concluded license, or under its declared license when the concluded one
+// is NONE, NOASSERTION or empty; each file counts under its concluded license. Packages or files with no usable license
+// information are not included here; see LicenseUnknownCount.
+func (r *SpdxReport) LicenseDistribution() map[string]int {
+	return r.licenseCounts
+}
+
+// LicenseUnknownCount returns how many packages and files fell back to
+// NOASSERTION, NONE, or had no license field set at all.
+func (r *SpdxReport) LicenseUnknownCount() int {
+	return r.licenseUnknown
+}
+
+// PackageIntegrity returns the fraction of packages that carry a usable
+// integrity signal: complete file digests when PackageFilesAnalyzed is
+// true, or a PackageVerificationCode when it is false. Unlike a blanket
+// file-digest ratio, this doesn't penalize packages that legitimately
+// declare FilesAnalyzed=false.
+func (r *SpdxReport) PackageIntegrity() scorecard.ReportValue {
+	if r.totalPackages == 0 {
+		return missingPackages
+	}
+	percent := scorecard.PrettyPercent(r.hasPackIntegrity, r.totalPackages)
+	return scorecard.ReportValue{
+		Ratio:     float32(r.hasPackIntegrity) / float32(r.totalPackages),
+		Reasoning: fmt.Sprintf("%d%% have either complete file digests (FilesAnalyzed=true) or a package verification code (FilesAnalyzed=false)", percent),
+	}
+}
+
+// RelationshipCompleteness scores how well the document's relationship
+// graph describes the actual dependency structure, rather than just
+// listing a flat bag of packages. It weighs whether SPDXRef-DOCUMENT
+// DESCRIBES at least one root package, and what fraction of packages
+// participate in at least one relationship (as subject or object).
+func (r *SpdxReport) RelationshipCompleteness() scorecard.ReportValue {
+	if r.totalPackages == 0 {
+		return missingPackages
+	}
+
+	var score float32
+	reasons := []string{}
+
+	if r.hasDescribesRoot {
+		score += 0.4
+	} else {
+		reasons = append(reasons, "No top-level DESCRIBES relationship from SPDXRef-DOCUMENT")
+	}
+
+	connectedPercent := scorecard.PrettyPercent(r.connectedPackages, r.totalPackages)
+	orphans := r.totalPackages - r.connectedPackages
+	score += 0.6 * float32(r.connectedPackages) / float32(r.totalPackages)
+	reasons = append(reasons, fmt.Sprintf("%d%% of packages participate in a relationship (%d orphaned)", connectedPercent, orphans))
+
+	return scorecard.ReportValue{
+		Ratio:     score,
+		Reasoning: strings.Join(reasons, ", "),
+	}
+}
+
 func (r *SpdxReport) CreationInfo() scorecard.ReportValue {
 	foundTool := false
 	hasVersion := false
+	var policyFailure *scorecard.ReportValue
 
 	if reflect.DeepEqual(r.doc, EmptyDocument) || r.doc.CreationInfo == nil {
 		return scorecard.ReportValue{
@@ -123,12 +277,26 @@ func (r *SpdxReport) CreationInfo() scorecard.ReportValue {
 	}
 
 	for _, creator := range r.doc.CreationInfo.Creators {
-		if creator.CreatorType == "Tool" {
-			foundTool = true
-			if isNumeric.MatchString(creator.Creator) {
-				hasVersion = true
-			}
+		if creator.CreatorType != "Tool" {
+			continue
 		}
+		foundTool = true
+		if isNumeric.MatchString(creator.Creator) {
+			hasVersion = true
+		}
+		if r.creatorPolicy == nil {
+			continue
+		}
+		tool, version, ok := parseCreatorTool(creator.Creator)
+		if !ok {
+			continue
+		}
+		result := r.creatorPolicy.Evaluate(tool, version)
+		if result.Ratio == 1 {
+			// Any one tool satisfying policy is enough; stop looking.
+			return result
+		}
+		policyFailure = &result
 	}
 
 	if !foundTool {
@@ -138,6 +306,16 @@ func (r *SpdxReport) CreationInfo() scorecard.ReportValue {
 		}
 	}
 
+	if r.creatorPolicy != nil {
+		if policyFailure != nil {
+			return *policyFailure
+		}
+		return scorecard.ReportValue{
+			Ratio:     0,
+			Reasoning: "No creator tool on the document satisfies policy",
+		}
+	}
+
 	var score float32
 	score = 1.0
 	reasons := []string{}
@@ -191,11 +369,19 @@ func GetSpdxReport(filename string) scorecard.SbomReport {
 	sr.docError = err
 
 	sr.valid = err == nil
+	sr.licenseCounts = map[string]int{}
+	excludedFiles := map[string]bool{}
 	if !reflect.DeepEqual(sr.doc, EmptyDocument) {
 		packages := sr.doc.Packages
+		packageFiles := packageFilesByID(sr.doc)
 
 		for _, p := range packages {
 			sr.totalPackages += 1
+			packageLicense := p.PackageLicenseConcluded
+			if packageLicense == "NONE" || packageLicense == "NOASSERTION" || packageLicense == "" {
+				packageLicense = p.PackageLicenseDeclared
+			}
+			recordLicense(sr.licenseCounts, &sr.licenseUnknown, packageLicense)
 			if p.PackageLicenseConcluded != "NONE" &&
 				p.PackageLicenseConcluded != "NOASSERTION" &&
 				p.PackageLicenseConcluded != "" {
@@ -223,21 +409,62 @@ func GetSpdxReport(filename string) scorecard.SbomReport {
 					foundCPE = true
 				}
 			}
-			if foundCPE && foundPURL {
+			if foundPURL || foundCPE {
 				sr.hasPurlOrCPE += 1
 			}
 
 			if p.PackageVersion != "" {
 				sr.hasPackVer += 1
 			}
+
+			files := packageFiles[fmt.Sprintf("%v", p.PackageSPDXIdentifier)]
+			if filesAnalyzed(p) {
+				complete := len(files) > 0
+				for _, file := range files {
+					if len(file.Checksums) == 0 {
+						complete = false
+					}
+				}
+				if complete {
+					sr.hasPackIntegrity += 1
+				}
+			} else {
+				for _, file := range files {
+					excludedFiles[fmt.Sprintf("%v", file.FileSPDXIdentifier)] = true
+				}
+				if hasVerificationCode(p) {
+					sr.hasPackIntegrity += 1
+				}
+			}
 		}
 
 		for _, file := range sr.doc.Files {
+			recordLicense(sr.licenseCounts, &sr.licenseUnknown, file.LicenseConcluded)
+			if excludedFiles[fmt.Sprintf("%v", file.FileSPDXIdentifier)] {
+				continue
+			}
 			sr.totalFiles += 1
 			if len(file.Checksums) > 0 {
 				sr.hasFileDigest += 1
 			}
 		}
+
+		connected := map[string]bool{}
+		for _, p := range packages {
+			connected[string(p.PackageSPDXIdentifier)] = false
+		}
+		for _, rel := range sr.doc.Relationships {
+			if rel.Relationship == "DESCRIBES" && isDocumentElement(rel.RefA) {
+				sr.hasDescribesRoot = true
+			}
+			markConnected(connected, rel.RefA)
+			markConnected(connected, rel.RefB)
+		}
+		for _, isConnected := range connected {
+			if isConnected {
+				sr.connectedPackages += 1
+			}
+		}
 	}
 	return &sr
 }
@@ -0,0 +1,44 @@
+package spdx
+
+import (
+	"testing"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsDocumentElement(t *testing.T) {
+	assert.True(t, isDocumentElement(spdx.DocElementID{ElementRefID: "DOCUMENT"}))
+	assert.False(t, isDocumentElement(spdx.DocElementID{ElementRefID: "Package-A"}))
+	assert.False(t, isDocumentElement(spdx.DocElementID{ElementRefID: "DOCUMENT", DocumentRefID: "DocumentRef-other"}))
+}
+
+func TestRelationshipCompleteness(t *testing.T) {
+	r := &SpdxReport{
+		totalPackages:     2,
+		hasDescribesRoot:  true,
+		connectedPackages: 1,
+	}
+
+	result := r.RelationshipCompleteness()
+	assert.InDelta(t, 0.7, result.Ratio, 0.01)
+	assert.Contains(t, result.Reasoning, "50% of packages participate in a relationship (1 orphaned)")
+}
+
+func TestRelationshipCompletenessNoDescribes(t *testing.T) {
+	r := &SpdxReport{
+		totalPackages:     2,
+		hasDescribesRoot:  false,
+		connectedPackages: 2,
+	}
+
+	result := r.RelationshipCompleteness()
+	assert.InDelta(t, 0.6, result.Ratio, 0.01)
+	assert.Contains(t, result.Reasoning, "No top-level DESCRIBES relationship from SPDXRef-DOCUMENT")
+}
+
+func TestRelationshipCompletenessNoPackages(t *testing.T) {
+	r := &SpdxReport{}
+	result := r.RelationshipCompleteness()
+	assert.Equal(t, missingPackages, result)
+}